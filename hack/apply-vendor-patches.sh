@@ -0,0 +1,15 @@
+#!/bin/sh
+# Reapply our local changes to vendored modules, which `go mod vendor`
+# reverts. Each patch in hack/vendor-patches is named for the path of the
+# module it applies to, e.g. hack/vendor-patches/github.com/hashicorp/terraform.patch
+# applies to vendor/github.com/hashicorp/terraform.
+set -eu
+
+cd "$(dirname "$0")/.."
+
+find hack/vendor-patches -type f -name '*.patch' | sort | while read -r PATCH; do
+  MODULE="${PATCH#hack/vendor-patches/}"
+  MODULE="${MODULE%.patch}"
+  echo "Applying ${PATCH} to vendor/${MODULE}"
+  git apply --directory="vendor/${MODULE}" "${PATCH}"
+done
@@ -2,7 +2,11 @@
 # Example:  ./hack/go-test.sh
 
 if [ "$IS_CONTAINER" != "" ]; then
-  go test ./cmd/... ./data/... ./pkg/... "${@}"
+  # go mod vendor leaves out test files, so any under vendor/ come from our
+  # patches in hack/vendor-patches, and we run those packages' tests too.
+  VENDOR_TEST_PACKAGES="$(find vendor -name '*_test.go' -exec dirname {} \; | sort -u | sed 's|^vendor/||')"
+  # shellcheck disable=SC2086
+  go test ./cmd/... ./data/... ./pkg/... ${VENDOR_TEST_PACKAGES} "${@}"
 else
   podman run --rm \
     --env IS_CONTAINER=TRUE \
@@ -4,6 +4,7 @@ if [ "$IS_CONTAINER" != "" ]; then
   set -euxo pipefail
   go mod tidy
   go mod vendor
+  ./hack/apply-vendor-patches.sh
   go mod verify
   git diff --exit-code
 else